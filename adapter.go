@@ -0,0 +1,41 @@
+package pariksha
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// HandlerAdapter abstracts over HTTP frameworks so that APITestCase's
+// RunSingle, RunBenchmark, and RunProfiling machinery works identically
+// regardless of whether the handler under test is written for Gin, Echo,
+// Fiber, or plain net/http.
+//
+// Implementations live in framework-specific subpackages (pariksha/gin,
+// pariksha/echo, pariksha/fiber, pariksha/nethttp) so that pariksha itself
+// does not need to import every supported framework.
+//
+// RunProfiling drives a single APITestCase's Adapter from many goroutines at
+// once via testing.B.RunParallel. BuildRequest must therefore return a fresh
+// Invocation per call rather than mutating state shared on the Adapter
+// itself, so that many goroutines can call BuildRequest on the same Adapter
+// concurrently without racing or needing every caller to remember a precise
+// BuildRequest/Invoke/ExtractResponse call sequence to avoid corrupting (or
+// permanently locking) shared Adapter state.
+type HandlerAdapter interface {
+	// BuildRequest wires the given *http.Request, context keys, and path
+	// params into whatever request/context representation the underlying
+	// framework expects, returning an Invocation ready for Invoke.
+	BuildRequest(req *http.Request, contextKeys map[string]any, pathParams map[string]string) (Invocation, error)
+}
+
+// Invocation represents a single call into the handler under test, as built
+// by HandlerAdapter.BuildRequest. It belongs to whichever goroutine built
+// it: call Invoke once, then ExtractResponse, without sharing the
+// Invocation with another goroutine.
+type Invocation interface {
+	// Invoke calls the handler under test against the request this
+	// Invocation was built for.
+	Invoke() error
+	// ExtractResponse returns the recorded response after Invoke has run.
+	ExtractResponse() *httptest.ResponseRecorder
+}
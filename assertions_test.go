@@ -0,0 +1,63 @@
+package pariksha_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pariksha "github.com/DevNavix/pariksha"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestRunAPITest_CustomAssertions covers the pluggable response matchers
+// (ExpectedBody, ExpectedJSONPath, ExpectedHeaders, ExpectedBodyRegex,
+// Unwrap) added alongside the legacy `{"response": {...}}` envelope check.
+func TestRunAPITest_CustomAssertions(t *testing.T) {
+	handler := func(c *gin.Context) {
+		c.Header("X-Request-Id", "abc123")
+		c.JSON(http.StatusOK, gin.H{"wrapped": gin.H{"id": 42, "name": "widget"}})
+	}
+	unwrap := func(body []byte) ([]byte, error) {
+		var outer struct {
+			Wrapped map[string]any `json:"wrapped"`
+		}
+		if err := json.Unmarshal(body, &outer); err != nil {
+			return nil, err
+		}
+		return json.Marshal(outer.Wrapped)
+	}
+
+	tests := []pariksha.APITestCase{
+		{
+			Name:             "jsonpath and headers",
+			Method:           http.MethodGet,
+			URL:              "/widgets/42",
+			HandlerFunc:      handler,
+			ExpectedCode:     http.StatusOK,
+			ExpectedJSONPath: map[string]any{"wrapped.id": float64(42)},
+			ExpectedHeaders:  map[string]string{"X-Request-Id": "abc123"},
+			T:                t,
+		},
+		{
+			Name:              "body regex",
+			Method:            http.MethodGet,
+			URL:               "/widgets/42",
+			HandlerFunc:       handler,
+			ExpectedCode:      http.StatusOK,
+			ExpectedBodyRegex: `"name":\s*"widget"`,
+			T:                 t,
+		},
+		{
+			Name:         "unwrap then exact body",
+			Method:       http.MethodGet,
+			URL:          "/widgets/42",
+			HandlerFunc:  handler,
+			ExpectedCode: http.StatusOK,
+			Unwrap:       unwrap,
+			ExpectedBody: map[string]any{"id": float64(42), "name": "widget"},
+			T:            t,
+		},
+	}
+	pariksha.RunAPITest(tests)
+}
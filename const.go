@@ -13,13 +13,16 @@ var (
 	// To print api response we should enable this flag
 	LogResponse = false
 	// Formats in which profiling results will be saved.
-	// Options: "png", "pdf". Add both to enable both outputs.
-	ProfilingOutputFormats = []string{"png"} //ex: []string{"png"} or []string{"pdf"} or []string{"png", "pdf"}
+	// Options: "png", "pdf", "svg", "txt". Add any combination to enable
+	// those outputs. "txt" renders pprof's `-top` report instead of a graph.
+	ProfilingOutputFormats = []string{"png"} //ex: []string{"png"} or []string{"pdf"} or []string{"png", "pdf", "svg", "txt"}
 )
 
 // Change `EnabledProfilingTypes` below to enable or disable specific profiling types.
 // Available options:
 // - "heap"          - "goroutine"      - "block"        - "mutex"        - "threadcreate"
+// - "fgprof"        - wall-clock profile (github.com/felixge/fgprof), useful for I/O-heavy handlers CPU profiles miss
+// - "trace"         - full-program runtime/trace capture, written to trace.out (viewed with `go tool trace`, not pprof)
 // Example:
 // To enable only heap and goroutine profiling, change it to:
 //
@@ -0,0 +1,90 @@
+// Package echo implements pariksha.HandlerAdapter for
+// github.com/labstack/echo/v4 handlers.
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	pariksha "github.com/DevNavix/pariksha"
+	"github.com/labstack/echo/v4"
+)
+
+// Adapter wraps an echo.HandlerFunc so it satisfies pariksha.HandlerAdapter.
+//
+// Invoke calls Handler on a context built from the Echo instance and, as
+// e.ServeHTTP would, feeds any returned error through the Echo instance's
+// configured HTTPErrorHandler, so an error returned by Handler (the normal
+// Echo idiom, e.g. echo.NewHTTPError) is turned into the actual response
+// instead of being silently discarded.
+//
+// Adapter itself holds no per-call state: BuildRequest returns a fresh
+// invocation for every call, so a single Adapter is safe to drive from many
+// goroutines at once (as RunProfiling does via testing.B.RunParallel).
+// The shared *echo.Echo is only read (NewContext, HTTPErrorHandler), never
+// mutated after New, so it needs no locking of its own.
+type Adapter struct {
+	Handler echo.HandlerFunc
+
+	echo *echo.Echo
+}
+
+// New returns an Adapter for the given Echo handler.
+func New(handler echo.HandlerFunc) *Adapter {
+	return &Adapter{Handler: handler, echo: echo.New()}
+}
+
+// invocation is the pariksha.Invocation returned by BuildRequest. Each call
+// gets its own, so concurrent BuildRequest calls on the same Adapter never
+// share a request/context keys/path params.
+type invocation struct {
+	handler     echo.HandlerFunc
+	echo        *echo.Echo
+	recorder    *httptest.ResponseRecorder
+	contextKeys map[string]any
+	pathParams  map[string]string
+	req         *http.Request
+}
+
+// BuildRequest stores req and the given context keys and path params so
+// Invoke can wire them into an Echo context. Invoke sets the path params
+// directly on that context (SetParamNames/SetParamValues), so c.Param(key)
+// returns them as it would behind a registered ":key" route; Echo's router
+// is not involved.
+func (a *Adapter) BuildRequest(req *http.Request, contextKeys map[string]any, pathParams map[string]string) (pariksha.Invocation, error) {
+	return &invocation{
+		handler:     a.Handler,
+		echo:        a.echo,
+		recorder:    httptest.NewRecorder(),
+		contextKeys: contextKeys,
+		pathParams:  pathParams,
+		req:         req,
+	}, nil
+}
+
+// Invoke builds an Echo context for the request, sets the path params and
+// context keys on it, and calls Handler. Any error Handler returns is handled
+// by echo's HTTPErrorHandler rather than silently discarded.
+func (i *invocation) Invoke() error {
+	ctx := i.echo.NewContext(i.req, i.recorder)
+	names := make([]string, 0, len(i.pathParams))
+	values := make([]string, 0, len(i.pathParams))
+	for key, value := range i.pathParams {
+		names = append(names, key)
+		values = append(values, value)
+	}
+	ctx.SetParamNames(names...)
+	ctx.SetParamValues(values...)
+	for key, value := range i.contextKeys {
+		ctx.Set(key, value)
+	}
+	if err := i.handler(ctx); err != nil {
+		i.echo.HTTPErrorHandler(err, ctx)
+	}
+	return nil
+}
+
+// ExtractResponse returns the recorder populated by Invoke.
+func (i *invocation) ExtractResponse() *httptest.ResponseRecorder {
+	return i.recorder
+}
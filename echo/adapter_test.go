@@ -0,0 +1,64 @@
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pecho "github.com/DevNavix/pariksha/echo"
+	"github.com/labstack/echo/v4"
+)
+
+func TestAdapter_PathParamsAndContext(t *testing.T) {
+	var gotID string
+	var gotUser any
+	handler := func(c echo.Context) error {
+		gotID = c.Param("id")
+		gotUser = c.Get("user")
+		return c.JSON(http.StatusOK, map[string]string{"id": gotID})
+	}
+
+	a := pecho.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	invocation, err := a.BuildRequest(req, map[string]any{"user": "alice"}, map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if gotID != "42" {
+		t.Errorf("handler saw path param %q, want %q", gotID, "42")
+	}
+	if gotUser != "alice" {
+		t.Errorf("handler saw context key %v, want %q", gotUser, "alice")
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
+
+// TestAdapter_ErrorGoesThroughHTTPErrorHandler guards against Invoke
+// discarding the error Handler returns: an error returned the normal Echo way (echo.NewHTTPError)
+// must produce the real status/body via the HTTPErrorHandler, not the
+// zero-value 200 httptest.NewRecorder starts with.
+func TestAdapter_ErrorGoesThroughHTTPErrorHandler(t *testing.T) {
+	handler := func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusTeapot, "no thanks")
+	}
+
+	a := pecho.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/brew", nil)
+	invocation, err := a.BuildRequest(req, nil, nil)
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if got := invocation.ExtractResponse().Code; got != http.StatusTeapot {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusTeapot)
+	}
+}
@@ -0,0 +1,149 @@
+// Package fiber implements pariksha.HandlerAdapter for
+// github.com/gofiber/fiber/v2 handlers.
+package fiber
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	pariksha "github.com/DevNavix/pariksha"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Adapter wraps a fiber.Handler so it satisfies pariksha.HandlerAdapter.
+//
+// Fiber has no built-in test-context recorder, so Invoke routes the request
+// through a throwaway *fiber.App via App.Test and copies the resulting
+// *http.Response into an httptest.ResponseRecorder so callers can keep using
+// the same recorder-based assertions regardless of framework. Path params
+// are registered as real ":key" route segments on the throwaway app (one
+// route per distinct pattern, cached across calls so repeated invocations
+// from RunBenchmark don't re-register routes on every iteration), so a
+// handler written the ordinary Fiber way (c.Params("id")) sees the same
+// value it would from a production router. Context keys have no Fiber
+// router equivalent, so they're still exposed via c.Locals.
+//
+// The throwaway *fiber.App and its route cache, plus a pointer to the
+// invocation currently running (see Invoke), are the only state the
+// Adapter keeps, and they're shared on purpose (so RunBenchmark/RunProfiling
+// don't re-register a route on every iteration). mu guards registering new
+// routes and running App.Test, both held only for the duration of a single
+// Invoke call, so a single Adapter can be driven by many goroutines at once
+// (as RunProfiling does via testing.B.RunParallel) without one caller's
+// Invoke ever racing another's.
+type Adapter struct {
+	Handler fiber.Handler
+
+	app     *fiber.App
+	mu      sync.Mutex
+	routes  map[string]bool
+	current *invocation
+}
+
+// New returns an Adapter for the given Fiber handler.
+func New(handler fiber.Handler) *Adapter {
+	a := &Adapter{Handler: handler, routes: map[string]bool{}}
+	a.app = fiber.New()
+	return a
+}
+
+// invocation is the pariksha.Invocation returned by BuildRequest. Each call
+// gets its own req/contextKeys/recorder; only the registration and
+// execution of the underlying route (done in Invoke) touch the Adapter's
+// shared app/routes.
+type invocation struct {
+	adapter     *Adapter
+	req         *http.Request
+	contextKeys map[string]any
+	pattern     string
+	recorder    *httptest.ResponseRecorder
+}
+
+// BuildRequest records the context keys and computes the route pattern for
+// req.URL.Path: each path segment that equals one of pathParams' values is
+// replaced with its ":key" placeholder, so a handler written the ordinary
+// Fiber way (c.Params("id")) sees the same value it would from a production
+// router. Matching whole segments (rather than substituting substrings in
+// the raw path) keeps this correct when one param's value is a prefix of
+// another segment, e.g. id="12" alongside orderId="1" on
+// "/users/12/orders/1". Each key is consumed at most once as segments are
+// scanned left to right, so two params that happen to share the same value
+// (e.g. orgId="42" and memberId="42" on "/orgs/42/members/42") still each
+// claim their own segment instead of one key's placeholder winning both.
+func (a *Adapter) BuildRequest(req *http.Request, contextKeys map[string]any, pathParams map[string]string) (pariksha.Invocation, error) {
+	remaining := make(map[string]string, len(pathParams))
+	for key, value := range pathParams {
+		remaining[key] = value
+	}
+	segments := strings.Split(req.URL.Path, "/")
+	for i, segment := range segments {
+		for key, value := range remaining {
+			if value == segment {
+				segments[i] = ":" + key
+				delete(remaining, key)
+				break
+			}
+		}
+	}
+
+	return &invocation{
+		adapter:     a,
+		req:         req,
+		contextKeys: contextKeys,
+		pattern:     strings.Join(segments, "/"),
+		recorder:    httptest.NewRecorder(),
+	}, nil
+}
+
+// Invoke registers i's route pattern on the Adapter's throwaway app (once
+// per distinct pattern) and sends the request through it. Registration and
+// the app.Test call run under the Adapter's lock, held only for this single
+// call, so concurrent Invoke calls on the same Adapter can't race on the
+// app's route table or step on each other's context keys.
+//
+// A cached route's handler closure is registered once but reused by every
+// later Invoke for the same pattern, so it can't capture i's context keys
+// directly; instead it reads them off adapter.current, which Invoke points
+// at itself before calling app.Test while still holding the lock.
+func (i *invocation) Invoke() error {
+	i.adapter.mu.Lock()
+	defer i.adapter.mu.Unlock()
+	i.adapter.current = i
+
+	if !i.adapter.routes[i.pattern] {
+		i.adapter.app.All(i.pattern, func(c *fiber.Ctx) error {
+			for key, value := range i.adapter.current.contextKeys {
+				c.Locals(key, value)
+			}
+			return i.adapter.Handler(c)
+		})
+		i.adapter.routes[i.pattern] = true
+	}
+
+	resp, err := i.adapter.app.Test(i.req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for key, values := range resp.Header {
+		for _, value := range values {
+			i.recorder.Header().Add(key, value)
+		}
+	}
+	i.recorder.Code = resp.StatusCode
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	i.recorder.Body.Write(body)
+	return nil
+}
+
+// ExtractResponse returns the recorder populated by Invoke.
+func (i *invocation) ExtractResponse() *httptest.ResponseRecorder {
+	return i.recorder
+}
@@ -0,0 +1,135 @@
+package fiber_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pfiber "github.com/DevNavix/pariksha/fiber"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestAdapter_PathParamsViaRealRouting guards against path params being
+// exposed only through c.Locals: a handler written the ordinary Fiber way
+// (c.Params("id")) must see the value from APITestCase.PathParams, the way
+// it would behind a real "/users/:id" route.
+func TestAdapter_PathParamsViaRealRouting(t *testing.T) {
+	var gotID string
+	handler := func(c *fiber.Ctx) error {
+		gotID = c.Params("id")
+		return c.SendString(gotID)
+	}
+
+	a := pfiber.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	invocation, err := a.BuildRequest(req, nil, map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if gotID != "42" {
+		t.Errorf("c.Params(\"id\") = %q, want %q", gotID, "42")
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
+
+// TestAdapter_PathParamsWithPrefixCollision guards against substring-based
+// pattern building: when one param's value is a prefix of another segment
+// (id="12" vs orderId="1" on "/users/12/orders/1"), replacing "/"+value
+// occurrences in the raw path would match "/1" inside "/12" first,
+// corrupting the pattern and leaving both params unresolved.
+func TestAdapter_PathParamsWithPrefixCollision(t *testing.T) {
+	var gotID, gotOrderID string
+	handler := func(c *fiber.Ctx) error {
+		gotID = c.Params("id")
+		gotOrderID = c.Params("orderId")
+		return c.SendStatus(http.StatusOK)
+	}
+
+	a := pfiber.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/users/12/orders/1", nil)
+	invocation, err := a.BuildRequest(req, nil, map[string]string{"id": "12", "orderId": "1"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if gotID != "12" {
+		t.Errorf("c.Params(\"id\") = %q, want %q", gotID, "12")
+	}
+	if gotOrderID != "1" {
+		t.Errorf("c.Params(\"orderId\") = %q, want %q", gotOrderID, "1")
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
+
+// TestAdapter_PathParamsWithSharedValue guards against matching path params
+// to route segments by value alone: when two distinct params happen to
+// share the same value (orgId="42" and memberId="42" on
+// "/orgs/42/members/42"), both segments must still get their own ":key"
+// placeholder instead of one key's placeholder claiming both occurrences.
+func TestAdapter_PathParamsWithSharedValue(t *testing.T) {
+	var gotOrgID, gotMemberID string
+	handler := func(c *fiber.Ctx) error {
+		gotOrgID = c.Params("orgId")
+		gotMemberID = c.Params("memberId")
+		return c.SendStatus(http.StatusOK)
+	}
+
+	a := pfiber.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/orgs/42/members/42", nil)
+	invocation, err := a.BuildRequest(req, nil, map[string]string{"orgId": "42", "memberId": "42"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if gotOrgID != "42" {
+		t.Errorf("c.Params(\"orgId\") = %q, want %q", gotOrgID, "42")
+	}
+	if gotMemberID != "42" {
+		t.Errorf("c.Params(\"memberId\") = %q, want %q", gotMemberID, "42")
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
+
+// TestAdapter_RepeatedInvokeSamePattern drives the same route pattern
+// through three BuildRequest/Invoke rounds, as a RunBenchmark loop does, and
+// checks that the handler runs exactly once per round. It does not observe
+// how many routes the throwaway app holds.
+func TestAdapter_RepeatedInvokeSamePattern(t *testing.T) {
+	calls := 0
+	handler := func(c *fiber.Ctx) error {
+		calls++
+		return c.SendStatus(http.StatusOK)
+	}
+
+	a := pfiber.New(handler)
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+		invocation, err := a.BuildRequest(req, nil, map[string]string{"id": "42"})
+		if err != nil {
+			t.Fatalf("BuildRequest: %v", err)
+		}
+		if err := invocation.Invoke(); err != nil {
+			t.Fatalf("Invoke: %v", err)
+		}
+		invocation.ExtractResponse()
+	}
+	if calls != 3 {
+		t.Errorf("handler called %d times, want 3", calls)
+	}
+}
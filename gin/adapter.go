@@ -0,0 +1,59 @@
+// Package gin implements pariksha.HandlerAdapter for github.com/gin-gonic/gin
+// handlers. It exists so APITestCase can drive Gin handlers through the same
+// Adapter field used for Echo, Fiber, and net/http.
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	pariksha "github.com/DevNavix/pariksha"
+	"github.com/gin-gonic/gin"
+)
+
+// Adapter wraps a gin.HandlerFunc so it satisfies pariksha.HandlerAdapter.
+//
+// Adapter itself holds no per-call state: BuildRequest returns a fresh
+// invocation for every call, so a single Adapter is safe to drive from many
+// goroutines at once (as RunProfiling does via testing.B.RunParallel).
+type Adapter struct {
+	Handler gin.HandlerFunc
+}
+
+// New returns an Adapter for the given Gin handler.
+func New(handler gin.HandlerFunc) *Adapter {
+	return &Adapter{Handler: handler}
+}
+
+// invocation is the pariksha.Invocation returned by BuildRequest. Each call
+// gets its own, so concurrent BuildRequest calls on the same Adapter never
+// share a gin.Context or recorder.
+type invocation struct {
+	handler  gin.HandlerFunc
+	recorder *httptest.ResponseRecorder
+	ctx      *gin.Context
+}
+
+// BuildRequest creates a Gin test context for req, attaching the given
+// context keys and path params the way gin.Context.Params expects them.
+func (a *Adapter) BuildRequest(req *http.Request, contextKeys map[string]any, pathParams map[string]string) (pariksha.Invocation, error) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = req
+	ctx.Keys = contextKeys
+	for key, value := range pathParams {
+		ctx.Params = append(ctx.Params, gin.Param{Key: key, Value: value})
+	}
+	return &invocation{handler: a.Handler, recorder: recorder, ctx: ctx}, nil
+}
+
+// Invoke calls the wrapped Gin handler with the context built by BuildRequest.
+func (i *invocation) Invoke() error {
+	i.handler(i.ctx)
+	return nil
+}
+
+// ExtractResponse returns the recorder populated by Invoke.
+func (i *invocation) ExtractResponse() *httptest.ResponseRecorder {
+	return i.recorder
+}
@@ -0,0 +1,40 @@
+package gin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pgin "github.com/DevNavix/pariksha/gin"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAdapter(t *testing.T) {
+	var gotID string
+	var gotUser any
+	handler := func(c *gin.Context) {
+		gotID = c.Param("id")
+		gotUser, _ = c.Get("user")
+		c.JSON(http.StatusOK, gin.H{"id": gotID})
+	}
+
+	a := pgin.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	invocation, err := a.BuildRequest(req, map[string]any{"user": "alice"}, map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if gotID != "42" {
+		t.Errorf("handler saw path param %q, want %q", gotID, "42")
+	}
+	if gotUser != "alice" {
+		t.Errorf("handler saw context key %v, want %q", gotUser, "alice")
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
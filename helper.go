@@ -2,6 +2,7 @@ package pariksha
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -12,30 +13,90 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"runtime"
 	"runtime/pprof"
+	"runtime/trace"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/felixge/fgprof"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 type APITestCase struct {
-	Name         string
-	Method       string
-	URL          string
-	HandlerFunc  gin.HandlerFunc
+	Name        string
+	Method      string
+	URL         string
+	HandlerFunc gin.HandlerFunc
+	// Adapter lets a test case target a framework other than Gin (Echo,
+	// Fiber, net/http, ...). When set, it takes precedence over
+	// HandlerFunc and all request building/invocation is routed through
+	// it instead of the Gin-specific path below. See HandlerAdapter.
+	Adapter      HandlerAdapter
 	RequestBody  string
 	PathParams   map[string]string
 	Headers      map[string]string
 	ExpectedCode int
+	// ExpectedBody, when set, requires the (possibly Unwrap-ped) response
+	// body to be JSON-equal to it, replacing the legacy `{"response": {...}}`
+	// envelope check below.
+	ExpectedBody any
+	// ExpectedJSONPath, when set, asserts each gjson path resolves to the
+	// given value in the (possibly Unwrap-ped) response body, e.g.
+	// {"data.id": 42}.
+	ExpectedJSONPath map[string]any
+	// ExpectedHeaders, when set, asserts each header is present on the
+	// recorded response with the given value.
+	ExpectedHeaders map[string]string
+	// ExpectedBodyRegex, when set, asserts the (possibly Unwrap-ped)
+	// response body matches this regular expression.
+	ExpectedBodyRegex string
+	// Unwrap, when set, runs on the raw response body before ExpectedBody,
+	// ExpectedJSONPath, and ExpectedBodyRegex are evaluated, letting teams
+	// whose handlers don't use the `{"response": {...}}` envelope (or use a
+	// different one) peel it off themselves.
+	Unwrap func([]byte) ([]byte, error)
+	// Retries, when > 0, lets RunSingle re-run ExecuteHandler and the
+	// assertions up to Retries additional times before failing, useful for
+	// handlers backed by eventually-consistent stores (Redis, Mongo, ...).
+	Retries int
+	// RetryBackoff is the base delay between attempts; it doubles after
+	// each failed attempt (so attempt 2 waits RetryBackoff, attempt 3 waits
+	// 2*RetryBackoff, and so on). Ignored when Retries is 0.
+	RetryBackoff time.Duration
+	// RetryOn decides whether a failed attempt should be retried. It
+	// defaults to retrying on any assertion failure when Retries > 0.
+	RetryOn func(*httptest.ResponseRecorder, error) bool
+	// ServeAddr, when set, makes RunBenchmark and RunProfiling start a live
+	// ServeProfiling server on this address before the benchmark loop and
+	// stop it afterward, so `go tool pprof` can attach mid-run instead of
+	// waiting for RunProfiling's post-hoc graphs.
+	ServeAddr string
+	// ServeOptions configures authentication for the ServeAddr server. See
+	// ProfilingServerOptions.
+	ServeOptions ProfilingServerOptions
 	ContextKeys  map[string]any
 	T            *testing.T
 	B            *testing.B
 	FunctionName string
 }
 
+// usesCustomAssertions reports whether tc opts into any of the pluggable
+// matchers, in which case RunSingle skips the legacy `{"response": {...}}`
+// envelope check entirely rather than requiring both.
+func (tc APITestCase) usesCustomAssertions() bool {
+	return tc.ExpectedBody != nil ||
+		tc.ExpectedJSONPath != nil ||
+		tc.ExpectedHeaders != nil ||
+		tc.ExpectedBodyRegex != "" ||
+		tc.Unwrap != nil
+}
+
 // RunAPITest is a helper function to execute a series of API test cases.
 // It iterates over a slice of APITestCase and runs each test case using the
 // provided testing framework.
@@ -47,35 +108,169 @@ type APITestCase struct {
 func RunAPITest(tests []APITestCase) {
 	for _, tc := range tests {
 		tc.T.Run(tc.Name, func(t *testing.T) {
+			tc.T = t
+			start := time.Now()
+			// require.FailNow ends the goroutine via runtime.Goexit, which
+			// still runs deferred functions, so metrics are reported here
+			// rather than after the tc.RunSingle() call below.
+			defer func() {
+				latency := time.Since(start)
+				if r := recover(); r != nil {
+					reportCaseResult(tc.Name, false, latency, true)
+					panic(r)
+				}
+				reportCaseResult(tc.Name, !t.Failed(), latency, false)
+			}()
 			tc.RunSingle()
 		})
 	}
 }
 
 // RunSingle executes a single API test case by simulating an HTTP request and validating the response against expected values.
+//
+// When none of ExpectedBody, ExpectedJSONPath, ExpectedHeaders,
+// ExpectedBodyRegex, or Unwrap are set, behavior is unchanged from before
+// those fields existed: the body must be a `{"response": {...}}` envelope
+// whose inner Resp.Code matches ExpectedCode. Setting any of them switches
+// to comparing ExpectedCode against the actual HTTP status and evaluating
+// only the matchers that were set, against the raw (or Unwrap-ped) body.
+//
+// When Retries is set, a failing attempt is retried (with exponential
+// backoff based on RetryBackoff, gated by RetryOn) up to Retries additional
+// times before the subtest is actually failed.
 func (tc APITestCase) RunSingle() {
 	// Mark this as a helper function to clean up test error stack traces
 	tc.T.Helper()
-	recorder := tc.ExecuteHandler()
-	if LogResponse {
-		// Log the raw response body for debugging
-		tc.T.Log("Raw Response Body:", recorder.Body.String())
-	}
-	var outer map[string]Resp
-	// Parse the JSON response into a map with key "response"
-	err := json.Unmarshal(recorder.Body.Bytes(), &outer)
-	require.NoError(tc.T, err, "Could not unmarshal JSON response")
-	// Extract the "response" object from the outer JSON map
-	resp, ok := outer["response"]
-	require.True(tc.T, ok, "Missing 'response' key in JSON")
-	// Assert that the returned response code matches the expected code
-	require.Equal(tc.T, tc.ExpectedCode, resp.Code)
+
+	retryOn := tc.RetryOn
+	if retryOn == nil {
+		retryOn = func(*httptest.ResponseRecorder, error) bool { return true }
+	}
+
+	var (
+		recorder *httptest.ResponseRecorder
+		checkErr error
+	)
+	attempts := tc.Retries + 1
+	for attempt := 1; attempt <= attempts; attempt++ {
+		recorder = tc.ExecuteHandler()
+		if LogResponse {
+			// Log the raw response body for debugging
+			tc.T.Log("Raw Response Body:", recorder.Body.String())
+		}
+
+		checkErr = tc.checkResponse(recorder)
+		if tc.Retries > 0 {
+			tc.T.Logf("%s: attempt %d/%d: %v", tc.Name, attempt, attempts, checkErr)
+		}
+		if checkErr == nil || attempt == attempts || !retryOn(recorder, checkErr) {
+			break
+		}
+		time.Sleep(tc.RetryBackoff * time.Duration(1<<(attempt-1)))
+	}
+
+	require.NoError(tc.T, checkErr)
+}
+
+// checkResponse runs the assertions described by tc against recorder and
+// returns the first one that fails, or nil if they all pass. It never calls
+// into testify/require so RunSingle can retry on failure instead of
+// immediately failing the subtest.
+func (tc APITestCase) checkResponse(recorder *httptest.ResponseRecorder) error {
+	if !tc.usesCustomAssertions() {
+		var outer map[string]Resp
+		// Parse the JSON response into a map with key "response"
+		if err := json.Unmarshal(recorder.Body.Bytes(), &outer); err != nil {
+			return fmt.Errorf("could not unmarshal JSON response: %w", err)
+		}
+		// Extract the "response" object from the outer JSON map
+		resp, ok := outer["response"]
+		if !ok {
+			return fmt.Errorf("missing 'response' key in JSON")
+		}
+		// Assert that the returned response code matches the expected code
+		if resp.Code != tc.ExpectedCode {
+			return fmt.Errorf("expected code %d, got %d", tc.ExpectedCode, resp.Code)
+		}
+		return nil
+	}
+
+	if recorder.Code != tc.ExpectedCode {
+		return fmt.Errorf("expected HTTP status %d, got %d", tc.ExpectedCode, recorder.Code)
+	}
+
+	body := recorder.Body.Bytes()
+	if tc.Unwrap != nil {
+		unwrapped, err := tc.Unwrap(body)
+		if err != nil {
+			return fmt.Errorf("unwrap failed: %w", err)
+		}
+		body = unwrapped
+	}
+
+	if tc.ExpectedBody != nil {
+		want, err := json.Marshal(tc.ExpectedBody)
+		if err != nil {
+			return fmt.Errorf("could not marshal ExpectedBody: %w", err)
+		}
+		if !jsonEqual(want, body) {
+			return fmt.Errorf("expected body %s, got %s", want, body)
+		}
+	}
+
+	for path, want := range tc.ExpectedJSONPath {
+		got := gjson.GetBytes(body, path)
+		if !got.Exists() {
+			return fmt.Errorf("JSONPath %q not found in response body", path)
+		}
+		wantJSON, err := json.Marshal(want)
+		if err != nil {
+			return fmt.Errorf("could not marshal expected value for JSONPath %q: %w", path, err)
+		}
+		if !jsonEqual(wantJSON, []byte(got.Raw)) {
+			return fmt.Errorf("JSONPath %q: expected %s, got %s", path, wantJSON, got.Raw)
+		}
+	}
+
+	if tc.ExpectedBodyRegex != "" {
+		if !regexp.MustCompile(tc.ExpectedBodyRegex).Match(body) {
+			return fmt.Errorf("response body did not match regex %q", tc.ExpectedBodyRegex)
+		}
+	}
+
+	for key, want := range tc.ExpectedHeaders {
+		if got := recorder.Header().Get(key); got != want {
+			return fmt.Errorf("header %q: expected %q, got %q", key, want, got)
+		}
+	}
+
+	return nil
+}
+
+// jsonEqual reports whether a and b are equal once both are decoded as JSON,
+// so e.g. differing key order or int-vs-float64 don't cause false negatives.
+func jsonEqual(a, b []byte) bool {
+	var av, bv any
+	if err := json.Unmarshal(a, &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
 }
 
 // To sets up the Gin test context, builds the HTTP request from the APITestCase fields,
 // executes the handler function, and returns the response recorder.
 // This is used for testing or benchmarking HTTP handler behavior.
+//
+// When tc.Adapter is set, request building and invocation are delegated to
+// it instead, so the same method works for Echo, Fiber, net/http, etc.
 func (tc *APITestCase) ExecuteHandler() *httptest.ResponseRecorder {
+	if tc.Adapter != nil {
+		return tc.executeWithAdapter()
+	}
+
 	// Create a new HTTP request using the method, URL, and request body defined in the test case
 	req, err := http.NewRequest(tc.Method, tc.URL, bytes.NewBufferString(tc.RequestBody))
 	if err != nil {
@@ -108,6 +303,28 @@ func (tc *APITestCase) ExecuteHandler() *httptest.ResponseRecorder {
 	return recorder
 }
 
+// executeWithAdapter builds the request, invokes the handler, and extracts
+// the response through tc.Adapter, mirroring the steps ExecuteHandler
+// performs directly for Gin.
+func (tc *APITestCase) executeWithAdapter() *httptest.ResponseRecorder {
+	req, err := http.NewRequest(tc.Method, tc.URL, bytes.NewBufferString(tc.RequestBody))
+	if err != nil {
+		log.Println("Error while hitting the request..", err)
+	}
+	for key, value := range tc.Headers {
+		req.Header.Set(key, value)
+	}
+
+	invocation, err := tc.Adapter.BuildRequest(req, tc.ContextKeys, tc.PathParams)
+	if err != nil {
+		log.Println("Error while building adapter request..", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		log.Println("Error while invoking handler..", err)
+	}
+	return invocation.ExtractResponse()
+}
+
 // If your Endpoint includes a path parameter, e.g., "/users/123",
 // and your handler expects it as "/users/:id", you must set it manually.
 func (tc APITestCase) SetAPITestPathParams() (params gin.Params) {
@@ -125,20 +342,81 @@ func (tc APITestCase) SetAPITestPathParams() (params gin.Params) {
 // calls the ExecuteHandler method for the number of iterations specified
 // by the testing.B instance.
 //
+// Both shipped MetricsSink implementations (pariksha/metrics/prometheus,
+// pariksha/metrics/influxdb) do a blocking network call per RecordResult, so
+// with b.N routinely in the millions, reporting per iteration would both
+// dominate the benchmark's own latency numbers and hammer whatever
+// Pushgateway/InfluxDB endpoint is configured. Instead, latency is
+// aggregated across each run of b.N iterations and reported once, as the
+// mean per-iteration latency, after the b.N iterations complete (or a
+// handler panic ends them early). The testing package calls the benchmark
+// function several times with growing b.N, so a sink sees one result per
+// such run rather than one per case.
+//
 // This method is designed to be used in conjunction with the Go testing
 // package's benchmarking tools.
 func RunBenchmark(tc APITestCase) {
 	tc.B.Helper() // Mark this as a helper function for better error reporting
 	tc.B.Run(tc.Name, func(b *testing.B) {
-		for i := 0; i < tc.B.N; i++ {
+		stopServeProfiling := tc.startServeProfiling()
+		defer stopServeProfiling()
+
+		sink := currentMetricsSink()
+		var (
+			totalLatency time.Duration
+			completed    int
+		)
+		defer func() {
+			if sink == nil {
+				return
+			}
+			avgLatency := time.Duration(0)
+			if completed > 0 {
+				avgLatency = totalLatency / time.Duration(completed)
+			}
+			if r := recover(); r != nil {
+				sink.RecordResult(tc.Name, false, avgLatency, true)
+				panic(r)
+			}
+			sink.RecordResult(tc.Name, !b.Failed(), avgLatency, false)
+		}()
+
+		for i := 0; i < b.N; i++ {
+			start := time.Now()
 			tc.ExecuteHandler()
+			totalLatency += time.Since(start)
+			completed++
 		}
 	})
 }
 
+// startServeProfiling starts a ServeProfiling server on tc.ServeAddr, if
+// set, and returns a func that stops it. When tc.ServeAddr is empty, the
+// returned func is a no-op, so callers can always `defer stop()`
+// unconditionally.
+func (tc APITestCase) startServeProfiling() (stop func()) {
+	if tc.ServeAddr == "" {
+		return func() {}
+	}
+	ps := ServeProfiling(tc.ServeAddr, tc.ServeOptions)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := ps.Stop(ctx); err != nil {
+			log.Printf("pariksha: failed to stop profiling server on %s: %v", tc.ServeAddr, err)
+		}
+	}
+}
+
 // RunProfiling executes the API test case with profiling enabled.
 // This method is useful for analyzing the performance of the test case
 // by collecting profiling data during its execution.
+//
+// Beyond the CPU profile and the runtime/pprof lookup profiles in
+// EnabledProfilingTypes, including "trace" captures a full-program
+// runtime/trace to trace.out (view with `go tool trace`), and including
+// "fgprof" captures a wall-clock profile via github.com/felixge/fgprof,
+// which surfaces I/O waits that a CPU profile misses.
 func RunProfiling(tc APITestCase) {
 	// Mark this method as a test helper to improve test failure reports
 	tc.B.Helper()
@@ -154,6 +432,9 @@ func RunProfiling(tc APITestCase) {
 	os.MkdirAll(profileDir, os.ModePerm)
 	// require.NoError(tc.T, err, "Failed to create profile directory")
 
+	stopServeProfiling := tc.startServeProfiling()
+	defer stopServeProfiling()
+
 	// --- CPU PROFILE (Temporary file) ---
 	cpuTempFile, err := os.CreateTemp("", "cpu_profile_*.prof")
 	require.NoError(tc.B, err, "Failed to create temp CPU profile file")
@@ -167,6 +448,27 @@ func RunProfiling(tc APITestCase) {
 	runtime.SetBlockProfileRate(1)
 	runtime.SetMutexProfileFraction(1)
 
+	// --- EXECUTION TRACE (optional) ---
+	traceEnabled := enabledProfilingType("trace")
+	if traceEnabled {
+		traceFile, err := os.Create(filepath.Join(profileDir, "trace.out"))
+		require.NoError(tc.B, err, "Failed to create trace output file")
+		defer traceFile.Close()
+		require.NoError(tc.B, trace.Start(traceFile), "Failed to start execution trace")
+	}
+
+	// --- FGPROF (wall-clock) PROFILE (optional, temporary file) ---
+	fgprofEnabled := enabledProfilingType("fgprof")
+	var fgprofTempFile *os.File
+	var stopFgprof func() error
+	if fgprofEnabled {
+		fgprofTempFile, err = os.CreateTemp("", "fgprof_*.prof")
+		require.NoError(tc.B, err, "Failed to create temp fgprof file")
+		defer os.Remove(fgprofTempFile.Name())
+		defer fgprofTempFile.Close()
+		stopFgprof = fgprof.Start(fgprofTempFile, fgprof.FormatPprof)
+	}
+
 	// Run the actual test case
 	// Run the benchmark in parallel using goroutines
 	tc.B.RunParallel(func(pb *testing.PB) {
@@ -178,10 +480,26 @@ func RunProfiling(tc APITestCase) {
 	// Stop CPU profiling after the test case execution
 	pprof.StopCPUProfile()
 
+	if traceEnabled {
+		trace.Stop()
+		log.Printf("execution trace written to %s", filepath.Join(profileDir, "trace.out"))
+	}
+
+	if fgprofEnabled {
+		if err := stopFgprof(); err != nil {
+			log.Printf("Failed to stop fgprof: %v", err)
+		} else {
+			for _, format := range ProfilingOutputFormats {
+				outputPath := filepath.Join(profileDir, fmt.Sprintf("fgprof.%s", format))
+				GenerateGraph(fgprofTempFile.Name(), outputPath, format)
+			}
+		}
+	}
+
 	// Generate user-selected output formats for the CPU profile
 	for _, format := range ProfilingOutputFormats {
-		if format != "png" && format != "pdf" {
-			tc.B.Logf("Invalid profiling output format: %s. Only 'png' and 'pdf' are supported.", format)
+		if !validProfilingOutputFormat(format) {
+			tc.B.Logf("Invalid profiling output format: %s. Only 'png', 'pdf', 'svg', and 'txt' are supported.", format)
 			return
 		}
 		outputPath := filepath.Join(profileDir, fmt.Sprintf("cpu.%s", format))
@@ -191,10 +509,24 @@ func RunProfiling(tc APITestCase) {
 	// Loop through other enabled profiles (e.g., heap, goroutine)
 	// Uncomment code in func EnabledProfilingTypes as per your profiling requirement
 	for _, profile := range EnabledProfilingTypes {
+		if profile == "trace" || profile == "fgprof" {
+			continue // captured around the run above instead of via pprof.Lookup
+		}
 		WriteProfileAndExport(profile, profileDir)
 	}
 }
 
+// enabledProfilingType reports whether name is present in
+// EnabledProfilingTypes.
+func enabledProfilingType(name string) bool {
+	for _, profile := range EnabledProfilingTypes {
+		if profile == name {
+			return true
+		}
+	}
+	return false
+}
+
 // To make a new directory, if directory not exists in the path.
 func MakeDirIfNotExists(path string) {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -239,10 +571,31 @@ func WriteProfileAndExport(profileType, dir string) {
 	}
 }
 
+// validProfilingOutputFormat reports whether format is one GenerateGraph
+// knows how to produce.
+func validProfilingOutputFormat(format string) bool {
+	switch format {
+	case "png", "pdf", "svg", "txt":
+		return true
+	default:
+		return false
+	}
+}
+
+// pprofFlag maps a ProfilingOutputFormats entry to the `go tool pprof` flag
+// that produces it. "txt" renders the textual `-top` report rather than a
+// graph image.
+func pprofFlag(format string) string {
+	if format == "txt" {
+		return "top"
+	}
+	return format
+}
+
 // GenerateGraph uses the Go pprof tool to generate a visualization of the profiling data.
-// It takes the path to a .prof file, the desired output path, and the output format ("png" or "pdf").
+// It takes the path to a .prof file, the desired output path, and the output format ("png", "pdf", "svg", or "txt").
 func GenerateGraph(profilePath, outputPath, format string) {
-	cmd := exec.Command("go", "tool", "pprof", fmt.Sprintf("-%s", format), profilePath)
+	cmd := exec.Command("go", "tool", "pprof", fmt.Sprintf("-%s", pprofFlag(format)), profilePath)
 	output, err := cmd.Output()
 	if err != nil {
 		log.Printf("Failed to generate %s for %s: %v", format, profilePath, err)
@@ -0,0 +1,47 @@
+package pariksha
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsSink receives per-case outcomes from RunAPITest and RunBenchmark so
+// CI can track pass/fail rates and latency regressions over time, rather
+// than relying only on per-run pprof images. Implementations live in
+// framework-specific subpackages, e.g. pariksha/metrics/prometheus (pushes
+// to a Pushgateway) and pariksha/metrics/influxdb (writes line protocol).
+type MetricsSink interface {
+	// RecordResult is called with whether a case passed, how long it took,
+	// and whether it panicked. RunAPITest calls it once per case after the
+	// case finishes; RunBenchmark calls it once per run of b.N iterations,
+	// with the mean per-iteration latency.
+	RecordResult(caseName string, passed bool, latency time.Duration, panicked bool)
+}
+
+var (
+	metricsSinkMu sync.RWMutex
+	metricsSink   MetricsSink
+)
+
+// SetMetricsSink installs the sink that RunAPITest and RunBenchmark report
+// results to. Pass nil to disable metrics reporting, which is the default.
+func SetMetricsSink(sink MetricsSink) {
+	metricsSinkMu.Lock()
+	defer metricsSinkMu.Unlock()
+	metricsSink = sink
+}
+
+// currentMetricsSink returns the sink installed by SetMetricsSink, or nil.
+func currentMetricsSink() MetricsSink {
+	metricsSinkMu.RLock()
+	defer metricsSinkMu.RUnlock()
+	return metricsSink
+}
+
+// reportCaseResult records a single case outcome to the configured
+// MetricsSink, if any. It's a no-op when no sink has been set.
+func reportCaseResult(caseName string, passed bool, latency time.Duration, panicked bool) {
+	if sink := currentMetricsSink(); sink != nil {
+		sink.RecordResult(caseName, passed, latency, panicked)
+	}
+}
@@ -0,0 +1,54 @@
+// Package influxdb implements pariksha.MetricsSink by writing line-protocol
+// points to an InfluxDB /write endpoint, so pariksha case results can be
+// tracked over time without running a Prometheus Pushgateway.
+package influxdb
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sink writes pariksha case results as InfluxDB line protocol to writeURL
+// (including whatever bucket/org/token query params the target InfluxDB
+// instance expects).
+type Sink struct {
+	writeURL   string
+	httpClient *http.Client
+}
+
+// New returns a Sink that POSTs line protocol to writeURL.
+func New(writeURL string) *Sink {
+	return &Sink{writeURL: writeURL, httpClient: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// RecordResult implements pariksha.MetricsSink.
+func (s *Sink) RecordResult(caseName string, passed bool, latency time.Duration, panicked bool) {
+	line := fmt.Sprintf(
+		"pariksha_case,case=%s passed=%t,latency_seconds=%f,panicked=%t %d\n",
+		escapeTag(caseName), passed, latency.Seconds(), panicked, time.Now().UnixNano(),
+	)
+
+	resp, err := s.httpClient.Post(s.writeURL, "text/plain; charset=utf-8", bytes.NewBufferString(line))
+	if err != nil {
+		// Write errors are logged, not surfaced as a test failure: metrics
+		// are best-effort telemetry and shouldn't fail a CI run that would
+		// otherwise pass.
+		log.Printf("pariksha/metrics/influxdb: write failed: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Printf("pariksha/metrics/influxdb: write returned status %d", resp.StatusCode)
+	}
+}
+
+// escapeTag escapes the characters InfluxDB line protocol treats specially
+// in tag values: commas, spaces, and equals signs.
+func escapeTag(s string) string {
+	replacer := strings.NewReplacer(",", "\\,", " ", "\\ ", "=", "\\=")
+	return replacer.Replace(s)
+}
@@ -0,0 +1,39 @@
+package influxdb_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DevNavix/pariksha/metrics/influxdb"
+)
+
+func TestSink_RecordResult(t *testing.T) {
+	var gotBody, gotContentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := influxdb.New(server.URL)
+	sink.RecordResult("checkout flow", true, 125*time.Millisecond, false)
+
+	if gotContentType != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want %q", gotContentType, "text/plain; charset=utf-8")
+	}
+	if !strings.Contains(gotBody, "pariksha_case,case=checkout\\ flow") {
+		t.Errorf("body %q missing expected measurement/tag, want case tag to escape the space in the case name", gotBody)
+	}
+	if !strings.Contains(gotBody, "passed=true") || !strings.Contains(gotBody, "panicked=false") {
+		t.Errorf("body %q missing expected fields", gotBody)
+	}
+	if !strings.Contains(gotBody, "latency_seconds=0.125") {
+		t.Errorf("body %q does not encode the 125ms latency in seconds", gotBody)
+	}
+}
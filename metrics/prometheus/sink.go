@@ -0,0 +1,73 @@
+// Package prometheus implements pariksha.MetricsSink using
+// github.com/prometheus/client_golang, pushing results to a Pushgateway so
+// CI can track pariksha case pass/fail rates and latency over time.
+package prometheus
+
+import (
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Sink pushes per-case pass/fail counts, a latency histogram, and panic
+// counts to a Prometheus Pushgateway after every RecordResult call.
+type Sink struct {
+	pusher  *push.Pusher
+	results *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+	panics  *prometheus.CounterVec
+}
+
+// New returns a Sink that pushes to the Pushgateway at gatewayURL under the
+// given job name. Pushes use the text exposition format rather than the
+// client's default protobuf-delimited one, so the pushed body is readable
+// by anything scraping the gateway's own /metrics (and by human eyes
+// debugging a push).
+func New(gatewayURL, job string) *Sink {
+	results := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pariksha_case_results_total",
+		Help: "Count of pariksha API test/benchmark case results by case name and outcome.",
+	}, []string{"case", "outcome"})
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "pariksha_case_latency_seconds",
+		Help: "Latency of pariksha API test/benchmark case executions.",
+	}, []string{"case"})
+	panics := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pariksha_case_panics_total",
+		Help: "Count of pariksha API test/benchmark cases whose handler panicked.",
+	}, []string{"case"})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(results, latency, panics)
+
+	return &Sink{
+		pusher: push.New(gatewayURL, job).Gatherer(registry).
+			Format(expfmt.NewFormat(expfmt.TypeTextPlain)),
+		results: results,
+		latency: latency,
+		panics:  panics,
+	}
+}
+
+// RecordResult implements pariksha.MetricsSink.
+func (s *Sink) RecordResult(caseName string, passed bool, latency time.Duration, panicked bool) {
+	outcome := "pass"
+	if !passed {
+		outcome = "fail"
+	}
+	s.results.WithLabelValues(caseName, outcome).Inc()
+	s.latency.WithLabelValues(caseName).Observe(latency.Seconds())
+	if panicked {
+		s.panics.WithLabelValues(caseName).Inc()
+	}
+
+	// Pushgateway errors are logged, not surfaced as a test failure:
+	// metrics are best-effort telemetry and shouldn't fail a CI run that
+	// would otherwise pass.
+	if err := s.pusher.Push(); err != nil {
+		log.Printf("pariksha/metrics/prometheus: push to gateway failed: %v", err)
+	}
+}
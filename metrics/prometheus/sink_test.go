@@ -0,0 +1,43 @@
+package prometheus_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DevNavix/pariksha/metrics/prometheus"
+)
+
+func TestSink_RecordResult(t *testing.T) {
+	var gotBody string
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := prometheus.New(server.URL, "pariksha_test")
+	sink.RecordResult("checkout flow", false, 50*time.Millisecond, true)
+
+	if requests != 1 {
+		t.Fatalf("pushgateway received %d requests, want 1", requests)
+	}
+	if !strings.Contains(gotBody, "pariksha_case_results_total") {
+		t.Errorf("pushed body missing pariksha_case_results_total, got %q", gotBody)
+	}
+	if !strings.Contains(gotBody, "pariksha_case_latency_seconds") {
+		t.Errorf("pushed body missing pariksha_case_latency_seconds, got %q", gotBody)
+	}
+	if !strings.Contains(gotBody, "pariksha_case_panics_total") {
+		t.Errorf("pushed body missing pariksha_case_panics_total, got %q", gotBody)
+	}
+	if !strings.Contains(gotBody, `outcome="fail"`) {
+		t.Errorf("pushed body missing outcome=fail label for a failed case, got %q", gotBody)
+	}
+}
@@ -0,0 +1,71 @@
+package pariksha_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pariksha "github.com/DevNavix/pariksha"
+
+	"github.com/gin-gonic/gin"
+)
+
+type recordedCall struct {
+	passed   bool
+	latency  time.Duration
+	panicked bool
+}
+
+type fakeSink struct {
+	calls []recordedCall
+}
+
+func (s *fakeSink) RecordResult(caseName string, passed bool, latency time.Duration, panicked bool) {
+	s.calls = append(s.calls, recordedCall{passed: passed, latency: latency, panicked: panicked})
+}
+
+// TestRunBenchmark_AggregatesMetricsNotPerIteration guards against
+// RecordResult being called once per b.N iteration: both shipped sinks do a
+// blocking network call per RecordResult, so reporting per iteration would
+// dominate the benchmark and hammer whatever endpoint is configured. This
+// asserts the sink sees fewer calls than handler invocations, rather
+// than a 1:1 ratio.
+func TestRunBenchmark_AggregatesMetricsNotPerIteration(t *testing.T) {
+	sink := &fakeSink{}
+	pariksha.SetMetricsSink(sink)
+	defer pariksha.SetMetricsSink(nil)
+
+	var handlerCalls int64
+	tc := pariksha.APITestCase{
+		Name:   "bench",
+		Method: http.MethodGet,
+		URL:    "/ping",
+		HandlerFunc: func(c *gin.Context) {
+			atomic.AddInt64(&handlerCalls, 1)
+			c.JSON(http.StatusOK, gin.H{"ok": true})
+		},
+	}
+
+	testing.Benchmark(func(b *testing.B) {
+		tc.B = b
+		pariksha.RunBenchmark(tc)
+	})
+
+	calls := atomic.LoadInt64(&handlerCalls)
+	if calls < 2 {
+		t.Fatalf("benchmark only exercised the handler %d time(s); test is inconclusive", calls)
+	}
+	if int64(len(sink.calls)) >= calls {
+		t.Errorf("RecordResult called %d times for %d handler invocations; want it aggregated once per run, not once per iteration",
+			len(sink.calls), calls)
+	}
+	for _, call := range sink.calls {
+		if call.panicked {
+			t.Error("panicked = true, want false for a handler that never panics")
+		}
+		if !call.passed {
+			t.Error("passed = false, want true")
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Package nethttp implements pariksha.HandlerAdapter for plain
+// net/http.Handler handlers.
+package nethttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	pariksha "github.com/DevNavix/pariksha"
+)
+
+// contextKey namespaces the values pariksha injects into the request
+// context so they don't collide with keys an application might set.
+type contextKey string
+
+// PathParam returns the path param named key from ctx, as set by Adapter
+// from the APITestCase's PathParams. Plain net/http has no router of its
+// own, so handlers under test that need path params (e.g. "/users/:id")
+// should read them back out with this helper instead of parsing the URL.
+func PathParam(ctx context.Context, key string) (string, bool) {
+	value, ok := ctx.Value(contextKey(key)).(string)
+	return value, ok
+}
+
+// Adapter wraps an http.Handler so it satisfies pariksha.HandlerAdapter.
+//
+// Adapter itself holds no per-call state: BuildRequest returns a fresh
+// invocation for every call, so a single Adapter is safe to drive from many
+// goroutines at once (as RunProfiling does via testing.B.RunParallel).
+type Adapter struct {
+	Handler http.Handler
+}
+
+// New returns an Adapter for the given net/http handler.
+func New(handler http.Handler) *Adapter {
+	return &Adapter{Handler: handler}
+}
+
+// invocation is the pariksha.Invocation returned by BuildRequest. Each call
+// gets its own, so concurrent BuildRequest calls on the same Adapter never
+// share a request/recorder.
+type invocation struct {
+	handler  http.Handler
+	req      *http.Request
+	recorder *httptest.ResponseRecorder
+}
+
+// BuildRequest attaches context keys directly to req's context, and exposes
+// path params through it too, readable via PathParam.
+func (a *Adapter) BuildRequest(req *http.Request, contextKeys map[string]any, pathParams map[string]string) (pariksha.Invocation, error) {
+	ctx := req.Context()
+	for key, value := range contextKeys {
+		ctx = context.WithValue(ctx, contextKey(key), value)
+	}
+	for key, value := range pathParams {
+		ctx = context.WithValue(ctx, contextKey(key), value)
+	}
+	return &invocation{
+		handler:  a.Handler,
+		req:      req.WithContext(ctx),
+		recorder: httptest.NewRecorder(),
+	}, nil
+}
+
+// Invoke calls the wrapped handler's ServeHTTP with the recorder and request
+// built by BuildRequest.
+func (i *invocation) Invoke() error {
+	i.handler.ServeHTTP(i.recorder, i.req)
+	return nil
+}
+
+// ExtractResponse returns the recorder populated by Invoke.
+func (i *invocation) ExtractResponse() *httptest.ResponseRecorder {
+	return i.recorder
+}
@@ -0,0 +1,35 @@
+package nethttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pnethttp "github.com/DevNavix/pariksha/nethttp"
+)
+
+func TestAdapter_PathParamsAndContext(t *testing.T) {
+	var gotID string
+	var gotOK bool
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID, gotOK = pnethttp.PathParam(r.Context(), "id")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	a := pnethttp.New(handler)
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	invocation, err := a.BuildRequest(req, map[string]any{"user": "alice"}, map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatalf("BuildRequest: %v", err)
+	}
+	if err := invocation.Invoke(); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	if !gotOK || gotID != "42" {
+		t.Errorf("PathParam(id) = (%q, %v), want (\"42\", true)", gotID, gotOK)
+	}
+	if got := invocation.ExtractResponse().Code; got != http.StatusOK {
+		t.Errorf("recorder code = %d, want %d", got, http.StatusOK)
+	}
+}
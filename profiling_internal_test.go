@@ -0,0 +1,40 @@
+package pariksha
+
+import "testing"
+
+func TestEnabledProfilingType(t *testing.T) {
+	original := EnabledProfilingTypes
+	defer func() { EnabledProfilingTypes = original }()
+
+	EnabledProfilingTypes = []string{"heap", "fgprof", "trace"}
+
+	if !enabledProfilingType("fgprof") {
+		t.Error("fgprof should be enabled")
+	}
+	if !enabledProfilingType("trace") {
+		t.Error("trace should be enabled")
+	}
+	if enabledProfilingType("mutex") {
+		t.Error("mutex should not be enabled")
+	}
+}
+
+func TestValidProfilingOutputFormat(t *testing.T) {
+	for _, format := range []string{"png", "pdf", "svg", "txt"} {
+		if !validProfilingOutputFormat(format) {
+			t.Errorf("%q should be a valid output format", format)
+		}
+	}
+	if validProfilingOutputFormat("jpeg") {
+		t.Error("jpeg should not be a valid output format")
+	}
+}
+
+func TestPprofFlag(t *testing.T) {
+	if got := pprofFlag("txt"); got != "top" {
+		t.Errorf("pprofFlag(txt) = %q, want %q", got, "top")
+	}
+	if got := pprofFlag("svg"); got != "svg" {
+		t.Errorf("pprofFlag(svg) = %q, want %q", got, "svg")
+	}
+}
@@ -0,0 +1,87 @@
+package pariksha
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"net/http/pprof"
+)
+
+// ProfilingServerOptions configures authentication for ServeProfiling. Both
+// fields are optional; leave both zero to serve without authentication
+// (only safe on a trusted, isolated machine).
+type ProfilingServerOptions struct {
+	// BearerToken, when set, requires "Authorization: Bearer <token>" on
+	// every request.
+	BearerToken string
+	// TLSConfig, when set, serves over TLS using this config, e.g. for
+	// mutual TLS (set ClientAuth and ClientCAs accordingly).
+	TLSConfig *tls.Config
+}
+
+// ProfilingServer is a goroutine-managed HTTP server exposing the standard
+// /debug/pprof/* endpoints (heap, allocs, goroutine, block, mutex, cpu,
+// trace), so `go tool pprof http://addr/debug/pprof/profile` can attach to
+// a benchmark while it's still running instead of waiting for RunProfiling's
+// post-hoc graphs.
+type ProfilingServer struct {
+	server *http.Server
+}
+
+// ServeProfiling starts an HTTP server on addr exposing /debug/pprof/* and
+// returns immediately; call Stop to shut it down. RunBenchmark and
+// RunProfiling start one automatically when tc.ServeAddr is set. See
+// ProfilingServerOptions for securing it with a bearer token or mTLS.
+func ServeProfiling(addr string, opts ProfilingServerOptions) *ProfilingServer {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	// heap, allocs, goroutine, block, mutex, and threadcreate are all
+	// served by pprof.Index through /debug/pprof/<name>.
+
+	var handler http.Handler = mux
+	if opts.BearerToken != "" {
+		handler = requireBearerToken(opts.BearerToken, handler)
+	}
+
+	server := &http.Server{Addr: addr, Handler: handler, TLSConfig: opts.TLSConfig}
+	ps := &ProfilingServer{server: server}
+
+	go func() {
+		var err error
+		if opts.TLSConfig != nil {
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Printf("pariksha: profiling server on %s stopped: %v", addr, err)
+		}
+	}()
+	return ps
+}
+
+// Stop gracefully shuts down the profiling server.
+func (ps *ProfilingServer) Stop(ctx context.Context) error {
+	return ps.server.Shutdown(ctx)
+}
+
+// requireBearerToken rejects any request whose Authorization header isn't
+// exactly "Bearer <token>". The comparison runs in constant time so a
+// shared-machine attacker can't recover the token byte-by-byte via timing.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	want := "Bearer " + token
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := r.Header.Get("Authorization")
+		if len(got) != len(want) || subtle.ConstantTimeCompare([]byte(got), []byte(want)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,37 @@
+package pariksha
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireBearerToken(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	protected := requireBearerToken("s3cr3t", ok)
+
+	cases := []struct {
+		name       string
+		authHeader string
+		wantCode   int
+	}{
+		{"missing header", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer nope", http.StatusUnauthorized},
+		{"prefix of the real token", "Bearer s3cr3", http.StatusUnauthorized},
+		{"correct token", "Bearer s3cr3t", http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			recorder := httptest.NewRecorder()
+			protected.ServeHTTP(recorder, req)
+			if recorder.Code != tc.wantCode {
+				t.Errorf("code = %d, want %d", recorder.Code, tc.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package pariksha_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pariksha "github.com/DevNavix/pariksha"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestRunAPITest_RetriesUntilSuccess covers the retry/flake handling added
+// to RunSingle: a handler that fails its first N-1 calls and succeeds on
+// the Nth must still pass, as long as Retries >= N-1.
+func TestRunAPITest_RetriesUntilSuccess(t *testing.T) {
+	attempt := 0
+	handler := func(c *gin.Context) {
+		attempt++
+		if attempt < 3 {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"response": gin.H{"code": http.StatusServiceUnavailable}})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"response": gin.H{"code": http.StatusOK}})
+	}
+
+	tests := []pariksha.APITestCase{
+		{
+			Name:         "eventually consistent",
+			Method:       http.MethodGet,
+			URL:          "/flaky",
+			HandlerFunc:  handler,
+			ExpectedCode: http.StatusOK,
+			Retries:      2,
+			T:            t,
+		},
+	}
+	pariksha.RunAPITest(tests)
+
+	if attempt != 3 {
+		t.Errorf("handler called %d times, want 3 (1 initial + 2 retries)", attempt)
+	}
+}
+
+// TestRunAPITest_RetryOnCustomPredicate covers RetryOn: a predicate that
+// only retries on a specific error condition must be consulted on every
+// failed attempt, and the handler retried exactly as many times as it says.
+func TestRunAPITest_RetryOnCustomPredicate(t *testing.T) {
+	attempt := 0
+	handler := func(c *gin.Context) {
+		attempt++
+		if attempt < 2 {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"response": gin.H{"code": http.StatusServiceUnavailable}})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"response": gin.H{"code": http.StatusOK}})
+	}
+
+	retryOnCalls := 0
+	tests := []pariksha.APITestCase{
+		{
+			Name:         "custom retry predicate",
+			Method:       http.MethodGet,
+			URL:          "/flaky",
+			HandlerFunc:  handler,
+			ExpectedCode: http.StatusOK,
+			Retries:      5,
+			RetryOn: func(*httptest.ResponseRecorder, error) bool {
+				retryOnCalls++
+				return true
+			},
+			T: t,
+		},
+	}
+	pariksha.RunAPITest(tests)
+
+	if attempt != 2 {
+		t.Errorf("handler called %d times, want 2", attempt)
+	}
+	if retryOnCalls != 1 {
+		t.Errorf("RetryOn called %d times, want 1 (once, for the single failed attempt)", retryOnCalls)
+	}
+}
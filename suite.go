@@ -0,0 +1,282 @@
+package pariksha
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// suiteFile is the top-level shape of a YAML/JSON test-suite file consumed
+// by LoadSuite.
+type suiteFile struct {
+	Fixtures map[string]suiteFixture `yaml:"fixtures" json:"fixtures"`
+	Cases    []suiteCase             `yaml:"cases" json:"cases"`
+}
+
+// suiteFixture holds a reusable headers/body pair that suiteCase entries can
+// pull in via a `$ref` to its key in suiteFile.Fixtures.
+type suiteFixture struct {
+	Headers map[string]string `yaml:"headers" json:"headers"`
+	Body    string            `yaml:"body" json:"body"`
+}
+
+// suiteCase is one entry under `cases:` in a suite file. It mirrors
+// APITestCase's fields closely so the mapping is obvious.
+type suiteCase struct {
+	Name              string            `yaml:"name" json:"name"`
+	Method            string            `yaml:"method" json:"method"`
+	URL               string            `yaml:"url" json:"url"`
+	Headers           map[string]string `yaml:"headers" json:"headers"`
+	Body              string            `yaml:"body" json:"body"`
+	PathParams        map[string]string `yaml:"path_params" json:"path_params"`
+	Context           map[string]any    `yaml:"context" json:"context"`
+	ExpectedCode      int               `yaml:"expected_code" json:"expected_code"`
+	ExpectedBody      any               `yaml:"expected_body" json:"expected_body"`
+	ExpectedJSONPath  map[string]any    `yaml:"expected_json_path" json:"expected_json_path"`
+	ExpectedHeaders   map[string]string `yaml:"expected_headers" json:"expected_headers"`
+	ExpectedBodyRegex string            `yaml:"expected_body_regex" json:"expected_body_regex"`
+	// Ref, when set, pulls Headers/Body from the named entry in
+	// suiteFile.Fixtures for whichever of the two this case left unset.
+	Ref string `yaml:"$ref" json:"$ref"`
+	// Matrix expands this single case definition into one case per
+	// combination of values, substituting "{{.key}}" placeholders in Name,
+	// URL, Body, and PathParams with each value before dispatch.
+	Matrix map[string][]string `yaml:"matrix" json:"matrix"`
+}
+
+// LoadSuite reads a YAML or JSON suite file at path (format picked from the
+// file extension) and returns the APITestCase slice it describes.
+//
+// Suite files support `fixtures:` for shared headers/bodies (pulled into a
+// case via `$ref: <fixture name>`) and a `matrix:` key on a case to expand
+// it into a param sweep before dispatch. Since a handler function can't be
+// expressed as data, the returned cases have HandlerFunc/Adapter and T left
+// unset — callers fill those in, e.g.:
+//
+//	tests, err := pariksha.LoadSuite("suite.yaml")
+//	for i := range tests {
+//		tests[i].HandlerFunc = myHandler
+//		tests[i].T = t
+//	}
+//	pariksha.RunAPITest(tests)
+//
+// RunAPITestFile wraps exactly that pattern for the common single-handler
+// suite.
+func LoadSuite(path string) ([]APITestCase, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("pariksha: could not read suite file %q: %w", path, err)
+	}
+
+	var file suiteFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(raw, &file)
+	case ".json":
+		err = json.Unmarshal(raw, &file)
+	default:
+		return nil, fmt.Errorf("pariksha: unsupported suite file extension %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pariksha: could not parse suite file %q: %w", path, err)
+	}
+
+	if err := validateSuite(&file); err != nil {
+		return nil, fmt.Errorf("pariksha: invalid suite file %q: %w", path, err)
+	}
+
+	baseDir := filepath.Dir(path)
+
+	var cases []suiteCase
+	for _, c := range file.Cases {
+		// Resolve $ref before expandMatrix, so a "{{.key}}" placeholder
+		// inside a fixture's body is substituted along with everything
+		// else, instead of running substitution before the fixture's body
+		// has even been merged in.
+		if c.Ref != "" {
+			fixture, ok := file.Fixtures[c.Ref]
+			if !ok {
+				return nil, fmt.Errorf("pariksha: case %q references unknown fixture %q", c.Name, c.Ref)
+			}
+			if c.Headers == nil {
+				c.Headers = fixture.Headers
+			}
+			if c.Body == "" {
+				c.Body = fixture.Body
+			}
+		}
+
+		expanded, err := expandMatrix(c)
+		if err != nil {
+			return nil, fmt.Errorf("pariksha: case %q: %w", c.Name, err)
+		}
+		cases = append(cases, expanded...)
+	}
+
+	tests := make([]APITestCase, 0, len(cases))
+	for _, c := range cases {
+		body, err := resolveInclude(baseDir, c.Body)
+		if err != nil {
+			return nil, fmt.Errorf("pariksha: case %q: %w", c.Name, err)
+		}
+		c.Body = body
+
+		tests = append(tests, APITestCase{
+			Name:              c.Name,
+			Method:            c.Method,
+			URL:               c.URL,
+			RequestBody:       c.Body,
+			PathParams:        c.PathParams,
+			Headers:           c.Headers,
+			ExpectedCode:      c.ExpectedCode,
+			ExpectedBody:      c.ExpectedBody,
+			ExpectedJSONPath:  c.ExpectedJSONPath,
+			ExpectedHeaders:   c.ExpectedHeaders,
+			ExpectedBodyRegex: c.ExpectedBodyRegex,
+			ContextKeys:       c.Context,
+		})
+	}
+	return tests, nil
+}
+
+// RunAPITestFile loads the suite at path, points every case at adapter and
+// t, and runs it through RunAPITest. This covers the common case of one
+// suite file exercising one handler with a sweep of inputs, for whichever
+// framework adapter is passed in (pariksha/gin, pariksha/echo,
+// pariksha/fiber, pariksha/nethttp, ...):
+//
+//	err := pariksha.RunAPITestFile("suite.yaml", gin.New(myHandler), t)
+func RunAPITestFile(path string, adapter HandlerAdapter, t *testing.T) error {
+	tests, err := LoadSuite(path)
+	if err != nil {
+		return err
+	}
+	for i := range tests {
+		tests[i].Adapter = adapter
+		tests[i].T = t
+	}
+	RunAPITest(tests)
+	return nil
+}
+
+// validateSuite checks a parsed suite file against pariksha's schema rules,
+// surfacing a precise, field-level error on the first problem found rather
+// than failing opaquely deep inside test execution.
+func validateSuite(file *suiteFile) error {
+	validMethods := map[string]bool{
+		"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "HEAD": true, "OPTIONS": true,
+	}
+	if len(file.Cases) == 0 {
+		return fmt.Errorf("suite must define at least one case")
+	}
+	for i, c := range file.Cases {
+		label := c.Name
+		if label == "" {
+			label = fmt.Sprintf("cases[%d]", i)
+		}
+		if c.Name == "" {
+			return fmt.Errorf("%s: missing required field \"name\"", label)
+		}
+		if c.Method == "" {
+			return fmt.Errorf("%s: missing required field \"method\"", label)
+		}
+		if !validMethods[strings.ToUpper(c.Method)] {
+			return fmt.Errorf("%s: invalid method %q", label, c.Method)
+		}
+		if c.URL == "" {
+			return fmt.Errorf("%s: missing required field \"url\"", label)
+		}
+		if c.Ref != "" {
+			if _, ok := file.Fixtures[c.Ref]; !ok {
+				return fmt.Errorf("%s: $ref %q does not match any entry under \"fixtures\"", label, c.Ref)
+			}
+		}
+		for key, values := range c.Matrix {
+			if len(values) == 0 {
+				return fmt.Errorf("%s: matrix key %q has no values", label, key)
+			}
+		}
+	}
+	return nil
+}
+
+// expandMatrix turns a single suiteCase with a `matrix:` block into one case
+// per combination of matrix values, substituting "{{.key}}" in Name, URL,
+// Body, and PathParams. A case without a matrix expands to itself unchanged.
+func expandMatrix(c suiteCase) ([]suiteCase, error) {
+	if len(c.Matrix) == 0 {
+		return []suiteCase{c}, nil
+	}
+
+	keys := make([]string, 0, len(c.Matrix))
+	for key := range c.Matrix {
+		keys = append(keys, key)
+	}
+
+	combos := []map[string]string{{}}
+	for _, key := range keys {
+		var next []map[string]string
+		for _, combo := range combos {
+			for _, value := range c.Matrix[key] {
+				merged := make(map[string]string, len(combo)+1)
+				for k, v := range combo {
+					merged[k] = v
+				}
+				merged[key] = value
+				next = append(next, merged)
+			}
+		}
+		combos = next
+	}
+
+	expanded := make([]suiteCase, 0, len(combos))
+	for _, combo := range combos {
+		copyCase := c
+		copyCase.Matrix = nil
+		copyCase.Name = substitutePlaceholders(c.Name, combo)
+		copyCase.URL = substitutePlaceholders(c.URL, combo)
+		copyCase.Body = substitutePlaceholders(c.Body, combo)
+		if c.PathParams != nil {
+			copyCase.PathParams = make(map[string]string, len(c.PathParams))
+			for k, v := range c.PathParams {
+				copyCase.PathParams[k] = substitutePlaceholders(v, combo)
+			}
+		}
+		expanded = append(expanded, copyCase)
+	}
+	return expanded, nil
+}
+
+// includePrefix marks a fixture or case body as a reference to an external
+// file, resolved relative to the suite file's own directory, e.g.:
+//
+//	body: "!include fixtures/create_user.json"
+const includePrefix = "!include "
+
+// resolveInclude expands a "!include <path>" body into the contents of the
+// referenced file. Bodies without the prefix are returned unchanged.
+func resolveInclude(baseDir, body string) (string, error) {
+	if !strings.HasPrefix(body, includePrefix) {
+		return body, nil
+	}
+	ref := strings.TrimSpace(strings.TrimPrefix(body, includePrefix))
+	raw, err := os.ReadFile(filepath.Join(baseDir, ref))
+	if err != nil {
+		return "", fmt.Errorf("could not resolve %q: %w", body, err)
+	}
+	return string(raw), nil
+}
+
+// substitutePlaceholders replaces every "{{.key}}" occurrence in s with the
+// corresponding value from combo.
+func substitutePlaceholders(s string, combo map[string]string) string {
+	for key, value := range combo {
+		s = strings.ReplaceAll(s, fmt.Sprintf("{{.%s}}", key), value)
+	}
+	return s
+}
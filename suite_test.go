@@ -0,0 +1,118 @@
+package pariksha_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pariksha "github.com/DevNavix/pariksha"
+)
+
+// TestLoadSuite_MatrixWithFixtureRef guards against expandMatrix running
+// before $ref fixture resolution: a "{{.key}}" placeholder inside a
+// fixture's body must still be substituted once the case also uses matrix.
+func TestLoadSuite_MatrixWithFixtureRef(t *testing.T) {
+	dir := t.TempDir()
+	suitePath := filepath.Join(dir, "suite.yaml")
+	content := `
+fixtures:
+  create_user:
+    headers:
+      Content-Type: application/json
+    body: '{"name": "{{.name}}"}'
+cases:
+  - name: create user {{.name}}
+    method: POST
+    url: /users
+    $ref: create_user
+    matrix:
+      name: ["alice", "bob"]
+    expected_code: 200
+`
+	if err := os.WriteFile(suitePath, []byte(content), 0644); err != nil {
+		t.Fatalf("write suite file: %v", err)
+	}
+
+	tests, err := pariksha.LoadSuite(suitePath)
+	if err != nil {
+		t.Fatalf("LoadSuite: %v", err)
+	}
+	if len(tests) != 2 {
+		t.Fatalf("got %d cases, want 2", len(tests))
+	}
+
+	want := map[string]string{
+		"create user alice": `{"name": "alice"}`,
+		"create user bob":   `{"name": "bob"}`,
+	}
+	for _, tc := range tests {
+		body, ok := want[tc.Name]
+		if !ok {
+			t.Fatalf("unexpected case name %q", tc.Name)
+		}
+		if tc.RequestBody != body {
+			t.Errorf("case %q: body = %q, want %q", tc.Name, tc.RequestBody, body)
+		}
+		if tc.Headers["Content-Type"] != "application/json" {
+			t.Errorf("case %q: headers = %v, want Content-Type from fixture", tc.Name, tc.Headers)
+		}
+	}
+}
+
+// TestRunAPITestFile_AcceptsHandlerAdapter guards against RunAPITestFile
+// only driving Gin handlers, the one shipped entry point where exercising a
+// non-Gin framework matters most.
+func TestRunAPITestFile_AcceptsHandlerAdapter(t *testing.T) {
+	dir := t.TempDir()
+	suitePath := filepath.Join(dir, "suite.yaml")
+	content := `
+cases:
+  - name: ping
+    method: GET
+    url: /ping
+    expected_code: 200
+    expected_body_regex: ".*"
+`
+	if err := os.WriteFile(suitePath, []byte(content), 0644); err != nil {
+		t.Fatalf("write suite file: %v", err)
+	}
+
+	called := false
+	adapter := &stubAdapter{onInvoke: func() { called = true }}
+	if err := pariksha.RunAPITestFile(suitePath, adapter, t); err != nil {
+		t.Fatalf("RunAPITestFile: %v", err)
+	}
+	if !called {
+		t.Error("adapter.Invoke was never called")
+	}
+}
+
+// stubAdapter is a minimal pariksha.HandlerAdapter used to confirm
+// RunAPITestFile drives whatever adapter it's given instead of assuming Gin.
+type stubAdapter struct {
+	onInvoke func()
+}
+
+func (a *stubAdapter) BuildRequest(*http.Request, map[string]any, map[string]string) (pariksha.Invocation, error) {
+	return &stubInvocation{onInvoke: a.onInvoke}, nil
+}
+
+// stubInvocation is the pariksha.Invocation returned by stubAdapter.
+type stubInvocation struct {
+	onInvoke func()
+}
+
+func (i *stubInvocation) Invoke() error {
+	if i.onInvoke != nil {
+		i.onInvoke()
+	}
+	return nil
+}
+
+func (i *stubInvocation) ExtractResponse() *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	recorder.Code = http.StatusOK
+	return recorder
+}